@@ -0,0 +1,101 @@
+package linalg
+
+import "errors"
+
+// LUDecomposition holds the result of factoring a matrix with [Matrix.Decompose], so that the same
+// factorization can be reused to solve multiple right-hand sides without repeating the elimination work.
+type LUDecomposition struct {
+	L      *Matrix
+	U      *Matrix
+	P      *Matrix
+	parity int
+}
+
+// Decompose factors the matrix into a reusable [LUDecomposition] using partial pivoting (see [Matrix.LUP]).
+// Once computed, the factorization can be used to solve as many right-hand sides as needed via
+// [LUDecomposition.Solve] without redoing the elimination.
+func (m *Matrix) Decompose() (*LUDecomposition, error) {
+	L, U, P, parity, err := m.LUP()
+	if err != nil {
+		return nil, err
+	}
+
+	return &LUDecomposition{L: L, U: U, P: P, parity: parity}, nil
+}
+
+// Solve solves A*x = b for x, given A's factorization P*A = L*U, by forward substitution on L*y = P*b
+// followed by back substitution on U*x = y. b may be a column matrix or a matrix with several columns,
+// in which case each column is solved independently, as if it were a separate right-hand side.
+func (lu *LUDecomposition) Solve(b *Matrix) (*Matrix, error) {
+	n := lu.L.lines
+
+	if b.lines != n {
+		return nil, errors.New("matrix b number of lines is different of the factorization's order")
+	}
+
+	pb, err := lu.P.Product(b)
+	if err != nil {
+		return nil, err
+	}
+
+	x_data := make([][]float64, n)
+	for i := range x_data {
+		x_data[i] = make([]float64, b.columns)
+	}
+
+	for col := 0; col < b.columns; col++ {
+		// forward substitution: L*y = P*b
+		y := make([]float64, n)
+		for i := 0; i < n; i++ {
+			sum := pb.data[i][col]
+			for j := 0; j < i; j++ {
+				sum -= lu.L.data[i][j] * y[j]
+			}
+			y[i] = sum / lu.L.data[i][i]
+		}
+
+		// back substitution: U*x = y
+		for i := n - 1; i >= 0; i-- {
+			sum := y[i]
+			for j := i + 1; j < n; j++ {
+				sum -= lu.U.data[i][j] * x_data[j][col]
+			}
+			x_data[i][col] = sum / lu.U.data[i][i]
+		}
+	}
+
+	return NewMatrix(x_data), nil
+}
+
+// Determinant returns the determinant of the factored matrix, computed as parity * prod(U[i][i]) so it
+// can be read directly off the factorization instead of running elimination again.
+func (lu *LUDecomposition) Determinant() float64 {
+	diag := float64(lu.parity)
+	for i := 0; i < lu.U.lines; i++ {
+		diag *= lu.U.data[i][i]
+	}
+	return diag
+}
+
+// Inverse returns the inverse of the factored matrix by solving against each column of the identity
+// matrix, reusing the factorization so the whole inverse costs one decomposition plus n back-solves
+// instead of redoing Gauss-Jordan elimination from scratch.
+func (lu *LUDecomposition) Inverse() (*Matrix, error) {
+	if lu.Determinant() == 0 {
+		return nil, errors.New("determinant is zero. Matrix cannot be inverted")
+	}
+
+	return lu.Solve(NewIdentityMatrix(lu.L.lines))
+}
+
+// Solve factors the matrix and solves A*x = b in one call, for callers that only need to solve a
+// single right-hand side and don't need to keep the factorization around. If several right-hand sides
+// need to be solved against the same A, prefer [Matrix.Decompose] followed by [LUDecomposition.Solve].
+func (m *Matrix) Solve(b *Matrix) (*Matrix, error) {
+	lu, err := m.Decompose()
+	if err != nil {
+		return nil, err
+	}
+
+	return lu.Solve(b)
+}
@@ -0,0 +1,79 @@
+package linalg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Decompose_Solve(t *testing.T) {
+	A := NewMatrix([][]float64{
+		{2, -1, -2},
+		{-4, 6, 3},
+		{-4, -2, 8},
+	})
+
+	lu, err := A.Decompose()
+	assert.Nil(t, err)
+
+	b := NewMatrix([][]float64{
+		{1},
+		{2},
+		{3},
+	})
+
+	x, err := lu.Solve(b)
+	assert.Nil(t, err)
+
+	// A*x should reproduce b
+	ax, err := A.Product(x)
+	assert.Nil(t, err)
+	assert.True(t, b.ApproxEquals(ax, DefaultTolerance))
+
+	// solving against several right-hand sides at once solves each column independently
+	B, err := NewIdentityMatrix(3).Product(A)
+	assert.Nil(t, err)
+
+	X, err := lu.Solve(B)
+	assert.Nil(t, err)
+	assert.True(t, NewIdentityMatrix(3).ApproxEquals(X, DefaultTolerance))
+}
+
+func Test_Decompose_Determinant(t *testing.T) {
+	A := NewMatrix([][]float64{
+		{0, 2},
+		{1, -1},
+	})
+
+	lu, err := A.Decompose()
+	assert.Nil(t, err)
+	assert.Equal(t, -2., lu.Determinant())
+}
+
+func Test_Matrix_Solve(t *testing.T) {
+	A := NewMatrix([][]float64{
+		{2, -1, -2},
+		{-4, 6, 3},
+		{-4, -2, 8},
+	})
+
+	b := NewMatrix([][]float64{
+		{1},
+		{2},
+		{3},
+	})
+
+	x, err := A.Solve(b)
+	assert.Nil(t, err)
+
+	ax, err := A.Product(x)
+	assert.Nil(t, err)
+	assert.True(t, b.ApproxEquals(ax, DefaultTolerance))
+
+	singular := NewMatrix([][]float64{
+		{1, 2},
+		{2, 4},
+	})
+	_, err = singular.Solve(b)
+	assert.Error(t, err)
+}
@@ -124,8 +124,10 @@ func (m *Matrix) Position(row int, col int) (float64, error) {
 
 // Sum returns a new matrix that is the result of the sum of the underlying matrix with the one passed as parameter
 // The matrix need to have the same size or an error will be returned
-func (m *Matrix) Sum(B *Matrix) (*Matrix, error) {
-	if m.lines != B.lines || m.columns != B.columns {
+// B can be any [MatrixView], so a view like [Matrix.TView] can be summed without materializing it first
+func (m *Matrix) Sum(B MatrixView) (*Matrix, error) {
+	rows, cols := B.Dims()
+	if m.lines != rows || m.columns != cols {
 		return nil, errors.New("Matrix size is not the same")
 	}
 
@@ -134,7 +136,7 @@ func (m *Matrix) Sum(B *Matrix) (*Matrix, error) {
 	for i := range result {
 		result[i] = make([]float64, m.columns)
 		for j := range result[i] {
-			result[i][j] = m.data[i][j] + B.data[i][j]
+			result[i][j] = m.data[i][j] + B.At(i, j)
 		}
 	}
 	return NewMatrix(result), nil
@@ -142,8 +144,10 @@ func (m *Matrix) Sum(B *Matrix) (*Matrix, error) {
 
 // Sub returns a new matrix that is the result of the subtraction of the underlying matrix with the one passed as parameter
 // The matrix need to have the same size or an error will be returned
-func (m *Matrix) Sub(B *Matrix) (*Matrix, error) {
-	if m.lines != B.lines || m.columns != B.columns {
+// B can be any [MatrixView], so a view like [Matrix.TView] can be subtracted without materializing it first
+func (m *Matrix) Sub(B MatrixView) (*Matrix, error) {
+	rows, cols := B.Dims()
+	if m.lines != rows || m.columns != cols {
 		return nil, errors.New("Matrix size is not the same")
 	}
 
@@ -152,7 +156,7 @@ func (m *Matrix) Sub(B *Matrix) (*Matrix, error) {
 	for i := range result {
 		result[i] = make([]float64, m.columns)
 		for j := range result[i] {
-			result[i][j] = m.data[i][j] - B.data[i][j]
+			result[i][j] = m.data[i][j] - B.At(i, j)
 		}
 	}
 	return NewMatrix(result), nil
@@ -186,17 +190,21 @@ func (m *Matrix) Transpose() *Matrix {
 
 // Product multiplies the matrix by another on ans returns a new resulting matrix
 // It also checks if the matrixes can be multiplied and if not, returns an error
-func (m *Matrix) Product(B *Matrix) (*Matrix, error) {
-	if m.columns != B.Lines() {
+// B can be any [MatrixView], so expressions like A.Product(B.TView()) don't need to
+// materialize the transpose first. The receiver is always a dense *Matrix: MatrixView has no
+// Product method, so a view such as A.TView() cannot be the left operand.
+func (m *Matrix) Product(B MatrixView) (*Matrix, error) {
+	rows, cols := B.Dims()
+	if m.columns != rows {
 		return nil, errors.New("matrix B number of lines id different of matrix A number of columns")
 	}
 
 	data := make([][]float64, m.lines)
 	for i := range data {
-		data[i] = make([]float64, B.columns)
+		data[i] = make([]float64, cols)
 		for j := range data[i] {
 			for k := 0; k < m.Columns(); k++ {
-				data[i][j] += m.data[i][k] * B.data[k][j]
+				data[i][j] += m.data[i][k] * B.At(k, j)
 			}
 		}
 	}
@@ -259,8 +267,91 @@ func (m *Matrix) LU() (L *Matrix, U *Matrix, err error) {
 	return NewMatrix(l_data), NewMatrix(u_data), nil
 }
 
+// pivotEpsilon is the threshold below which a pivot candidate is considered zero for the purposes of LUP
+const pivotEpsilon = 1e-12
+
+// LUP decomposes the matrix into a lower (L) and upper (U) triangular matrix together with a permutation
+// matrix (P) such that P*A = L*U. Unlike [Matrix.LU], it performs partial pivoting: for each column it
+// picks the row with the largest absolute value as the pivot, which keeps the decomposition numerically
+// stable and makes it succeed on matrices that plain Doolittle elimination would stumble on (e.g. ones
+// whose natural pivot is zero).
+// parity is 1 if an even number of row swaps were performed and -1 if odd, which is what's needed to
+// recover the sign of the determinant from U's diagonal.
+// It requires a square matrix and returns an error if the matrix is singular to working precision.
+func (m *Matrix) LUP() (L *Matrix, U *Matrix, P *Matrix, parity int, err error) {
+	if m.lines != m.columns {
+		return nil, nil, nil, 0, errors.New("matrix can't be decomposed, number of lines and columns are different")
+	}
+
+	n := m.lines
+	parity = 1
+
+	// p tracks which original row ends up in each position of the working matrix
+	p := make([]int, n)
+	work := make([][]float64, n)
+	for i := 0; i < n; i++ {
+		p[i] = i
+		work[i] = make([]float64, n)
+		copy(work[i], m.data[i])
+	}
+
+	l_data := make([][]float64, n)
+	for i := range l_data {
+		l_data[i] = make([]float64, n)
+	}
+
+	for k := 0; k < n; k++ {
+		// find the row with the largest absolute value in column k, at or below k
+		maxRow := k
+		maxVal := abs(work[k][k])
+		for i := k + 1; i < n; i++ {
+			if v := abs(work[i][k]); v > maxVal {
+				maxVal = v
+				maxRow = i
+			}
+		}
+
+		if maxVal < pivotEpsilon {
+			return nil, nil, nil, 0, errors.New("singular to working precision")
+		}
+
+		if maxRow != k {
+			work[k], work[maxRow] = work[maxRow], work[k]
+			l_data[k], l_data[maxRow] = l_data[maxRow], l_data[k]
+			p[k], p[maxRow] = p[maxRow], p[k]
+			parity = -parity
+		}
+
+		l_data[k][k] = 1.
+
+		for i := k + 1; i < n; i++ {
+			multiplier := work[i][k] / work[k][k]
+			l_data[i][k] = multiplier
+			for j := k; j < n; j++ {
+				work[i][j] -= multiplier * work[k][j]
+			}
+		}
+	}
+
+	p_data := make([][]float64, n)
+	for i := range p_data {
+		p_data[i] = make([]float64, n)
+		p_data[i][p[i]] = 1
+	}
+
+	return NewMatrix(l_data), NewMatrix(work), NewMatrix(p_data), parity, nil
+}
+
+// abs returns the absolute value of a float64
+func abs(v float64) float64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
 // Determinant returns de determinant value of a square matrix or an error if it cannot be calculated
-// It uses the method of LU Decomposition (Doolittle) to achieve the goal for a n x n matrix
+// It uses the method of LUP Decomposition (partial pivoting) to achieve the goal for a n x n matrix
 func (m *Matrix) Determinant() (float64, error) {
 	if m.lines == 0 || m.columns == 0 {
 		return 0, errors.New("empty matrix")
@@ -278,8 +369,12 @@ func (m *Matrix) Determinant() (float64, error) {
 		return m.data[0][0]*m.data[1][1] - m.data[0][1]*m.data[1][0], nil
 	}
 
-	_, U, _ := m.LU()
-	diag := 1.
+	_, U, _, parity, err := m.LUP()
+	if err != nil {
+		return 0, nil
+	}
+
+	diag := float64(parity)
 	for i := 0; i < U.lines; i++ {
 		diag *= U.data[i][i]
 	}
@@ -288,14 +383,16 @@ func (m *Matrix) Determinant() (float64, error) {
 
 // Equals determines if the underlying matrix is equal to another one.
 // By Equals, is considered that every value is equal to another one in the other matrix
-func (m *Matrix) Equals(B *Matrix) bool {
-	if m.lines != B.lines || m.columns != B.columns {
+// B can be any [MatrixView]
+func (m *Matrix) Equals(B MatrixView) bool {
+	rows, cols := B.Dims()
+	if m.lines != rows || m.columns != cols {
 		return false
 	}
 
 	for i := 0; i < m.lines; i++ {
 		for j := 0; j < m.columns; j++ {
-			if m.data[i][j] != B.data[i][j] {
+			if m.data[i][j] != B.At(i, j) {
 				return false
 			}
 		}
@@ -304,91 +401,62 @@ func (m *Matrix) Equals(B *Matrix) bool {
 	return true
 }
 
-// Inverse returns a new matrix being the inverse form of the original one
-// If the matrix os not square it returns an error
-// If the determinant of the matrix is 0, there is no inverse for the matrix and an error will be returned
-func (m *Matrix) Inverse() (*Matrix, error) {
-	if m.lines != m.columns {
-		return nil, errors.New("matrix musts be square")
-	}
-
-	det, err := m.Determinant()
-	if err != nil {
-		return nil, err
-	}
-
-	if det == 0 {
-		return nil, errors.New("determinant is zero. Matrix cannot be inverted")
-	}
-
-	/*
-			1. Form the augmented matrix by the identity matrix.
-		  2. Perform the row reduction operation on this augmented matrix to generate a row reduced echelon form of the matrix.
-		  3. The following row operations are performed on augmented matrix when required:
-		     - Interchange any two row.
-		     - Multiply each element of row by a non-zero integer.
-		     - Replace a row by the sum of itself and a constant multiple of another row of the matrix.
-	*/
-
-	order := m.lines
-	augmented := make([][]float64, order)
-	// copy m.data to augmented
-	for i := range augmented {
-		augmented[i] = make([]float64, order*2)
-		for j := 0; j < order; j++ {
-			augmented[i][j] = m.data[i][j]
-		}
+// DefaultTolerance is a reasonable default tolerance to pass to [Matrix.ApproxEquals] when comparing
+// matrices derived from numerical algorithms such as LU, inverse, or eigenvalue computations.
+const DefaultTolerance = 1e-9
+
+// ApproxEquals determines if the underlying matrix is approximately equal to another one within the
+// given tolerance, using a mixed absolute/relative criterion: |a-b| <= tol * max(1, |a|, |b|).
+// Unlike [Matrix.Equals], which compares float64 values with exact bitwise equality, ApproxEquals is
+// the right choice for anything derived from LU, inverse, or other iterative numerical methods, where
+// the exact bit pattern of a result depends on the order operations were performed in.
+func (m *Matrix) ApproxEquals(B MatrixView, tol float64) bool {
+	rows, cols := B.Dims()
+	if m.lines != rows || m.columns != cols {
+		return false
 	}
 
-	for i := 0; i < order; i++ {
-
-		// create augmented matrix
-		for j := 0; j < order*2; j++ {
-			// add 1 to diagonal places of augmented part
-			if j == (i + order) {
-				augmented[i][j] = 1
+	for i := 0; i < m.lines; i++ {
+		for j := 0; j < m.columns; j++ {
+			a, b := m.data[i][j], B.At(i, j)
+			bound := tol * max3(1, abs(a), abs(b))
+			if abs(a-b) > bound {
+				return false
 			}
 		}
 	}
 
-	// Interchange the row of matrix, starting in last row
-	for i := order - 1; i > 0; i-- {
-		if augmented[i-1][0] < augmented[i][0] {
-			tempSlice := augmented[i]
-			augmented[i] = augmented[i-1]
-			augmented[i-1] = tempSlice
-		}
-	}
+	return true
+}
 
-	// Replace a row by sum of itself and a
-	for i := 0; i < order; i++ {
-		for j := 0; j < order; j++ {
-			if j != i {
-				temp := augmented[j][i] / augmented[i][i]
-				for k := 0; k < order*2; k++ {
-					augmented[j][k] -= augmented[i][k] * temp
-				}
-			}
-		}
+// max3 returns the largest of three float64 values
+func max3(a, b, c float64) float64 {
+	m := a
+	if b > m {
+		m = b
 	}
+	if c > m {
+		m = c
+	}
+	return m
+}
 
-	for i := 0; i < order; i++ {
-		temp := augmented[i][i]
-		for j := 0; j < order*2; j++ {
-			augmented[i][j] /= temp
-		}
+// Inverse returns a new matrix being the inverse form of the original one
+// If the matrix os not square it returns an error
+// If the determinant of the matrix is 0, there is no inverse for the matrix and an error will be returned
+// Internally it factors the matrix once via [Matrix.Decompose] and reuses that factorization
+// ([LUDecomposition.Inverse]) instead of running Gauss-Jordan elimination on an augmented matrix
+func (m *Matrix) Inverse() (*Matrix, error) {
+	if m.lines != m.columns {
+		return nil, errors.New("matrix musts be square")
 	}
 
-	// building the result matrix
-	result_data := make([][]float64, order)
-	for i := range result_data {
-		result_data[i] = make([]float64, order)
-		for j := range result_data[i] {
-			result_data[i][j] = augmented[i][order+j]
-		}
+	lu, err := m.Decompose()
+	if err != nil {
+		return nil, err
 	}
-	return NewMatrix(result_data), nil
 
+	return lu.Inverse()
 }
 
 func (m *Matrix) String() string {
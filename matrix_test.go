@@ -368,8 +368,8 @@ func Test_LU(t *testing.T) {
 
 	l, u, err := A.LU()
 	assert.Nil(t, err)
-	assert.True(t, L.Equals(l))
-	assert.True(t, U.Equals(u))
+	assert.True(t, L.ApproxEquals(l, DefaultTolerance))
+	assert.True(t, U.ApproxEquals(u, DefaultTolerance))
 
 	// asserting property
 	// if B = L * U
@@ -377,8 +377,51 @@ func Test_LU(t *testing.T) {
 	// This proves the decomposition preserves the original matrix values
 	B, err := L.Product(U)
 	assert.Nil(t, err)
-	assert.True(t, A.Equals(B))
+	assert.True(t, A.ApproxEquals(B, DefaultTolerance))
+
+}
+
+func Test_LUP(t *testing.T) {
+	A := NewMatrix([][]float64{
+		{0, 2},
+		{1, -1},
+	})
+
+	l, u, p, parity, err := A.LUP()
+	assert.Nil(t, err)
+
+	// P*A should equal L*U
+	pa, err := p.Product(A)
+	assert.Nil(t, err)
+
+	lu, err := l.Product(u)
+	assert.Nil(t, err)
 
+	assert.True(t, pa.ApproxEquals(lu, DefaultTolerance))
+	assert.Equal(t, -1, parity)
+
+	// larger matrix: whatever pivoting happens, P*A must equal L*U and parity must be +-1
+	B := NewMatrix([][]float64{
+		{2, -1, -2},
+		{-4, 6, 3},
+		{-4, -2, 8},
+	})
+
+	l, u, p, parity, err = B.LUP()
+	assert.Nil(t, err)
+	assert.True(t, parity == 1 || parity == -1)
+
+	pb, _ := p.Product(B)
+	lu, _ = l.Product(u)
+	assert.True(t, pb.ApproxEquals(lu, DefaultTolerance))
+
+	// a singular matrix should return an error
+	singular := NewMatrix([][]float64{
+		{1, 2},
+		{2, 4},
+	})
+	_, _, _, _, err = singular.LUP()
+	assert.Error(t, err)
 }
 
 func Test_Determinant(t *testing.T) {
@@ -455,7 +498,7 @@ func Test_Inverse(t *testing.T) {
 
 	inv, err := A.Inverse()
 	assert.Nil(t, err)
-	assert.True(t, Ai_expected.Equals(inv))
+	assert.True(t, Ai_expected.ApproxEquals(inv, DefaultTolerance))
 
 	// inverse of the identity is equals to the identity
 	I := NewIdentityMatrix(2)
@@ -0,0 +1,251 @@
+package linalg
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// householder holds a single Householder reflector H = I - beta*v*v^T, applied starting at row/column k
+type householder struct {
+	k    int
+	v    []float64
+	beta float64
+}
+
+// newHouseholder builds the reflector that zeroes every entry of x below the first one: it picks
+// v = x + sign(x[0])*||x||*e1, normalized so v[0] = 1, keeping beta = 2/(v.v). Adding rather than
+// subtracting keeps v[0] from cancelling to zero when x is already nearly aligned with e1 (the
+// numerically stable convention used by LAPACK/gonum). It returns ok = false when x is already zero
+// or v[0] still rounds to zero, in which case no reflection is applied. A one-element x is not
+// special-cased: a nonzero one yields v = [1] and beta = 2, a reflector that negates it.
+func newHouseholder(x []float64) (v []float64, beta float64, ok bool) {
+	normX := 0.
+	for _, xi := range x {
+		normX += xi * xi
+	}
+	normX = math.Sqrt(normX)
+	if normX == 0 {
+		return nil, 0, false
+	}
+
+	sign := 1.
+	if x[0] < 0 {
+		sign = -1.
+	}
+
+	v = make([]float64, len(x))
+	copy(v, x)
+	v[0] += sign * normX
+
+	vDotV := 0.
+	for _, vi := range v {
+		vDotV += vi * vi
+	}
+	if vDotV == 0 {
+		return nil, 0, false
+	}
+
+	beta = 2. / vDotV
+	v0 := v[0]
+	if v0 == 0 {
+		return nil, 0, false
+	}
+	for i := range v {
+		v[i] /= v0
+	}
+	beta *= v0 * v0
+
+	return v, beta, true
+}
+
+// applyHouseholderLeft applies H = I - beta*v*v^T to rows k..k+len(v)-1 of data, across all n columns:
+// data[k:, :] -= beta*v*(v^T * data[k:, :])
+func applyHouseholderLeft(data [][]float64, k int, v []float64, beta float64, n int) {
+	size := len(v)
+	for j := 0; j < n; j++ {
+		dot := 0.
+		for i := 0; i < size; i++ {
+			dot += v[i] * data[k+i][j]
+		}
+		for i := 0; i < size; i++ {
+			data[k+i][j] -= beta * v[i] * dot
+		}
+	}
+}
+
+// applyHouseholderRight applies H = I - beta*v*v^T to columns k..k+len(v)-1 of data, across all n rows:
+// data[:, k:] -= beta*(data[:, k:]*v)*v^T
+func applyHouseholderRight(data [][]float64, k int, v []float64, beta float64, n int) {
+	size := len(v)
+	for i := 0; i < n; i++ {
+		dot := 0.
+		for j := 0; j < size; j++ {
+			dot += data[i][k+j] * v[j]
+		}
+		for j := 0; j < size; j++ {
+			data[i][k+j] -= beta * dot * v[j]
+		}
+	}
+}
+
+// QR decomposes the matrix into an orthogonal matrix Q and an upper triangular matrix R such that
+// A = Q*R, using Householder reflections: for each column k, a reflector is formed from R[k:, k]
+// that zeroes everything below the diagonal in that column, and is applied to R[k:, k:]. Q is
+// accumulated by applying the same reflectors, in reverse order, to the identity matrix.
+// It requires a square matrix.
+func (m *Matrix) QR() (Q *Matrix, R *Matrix, err error) {
+	if m.lines != m.columns {
+		return nil, nil, errors.New("matrix must be square")
+	}
+
+	n := m.lines
+	r_data := make([][]float64, n)
+	for i := range r_data {
+		r_data[i] = make([]float64, n)
+		copy(r_data[i], m.data[i])
+	}
+
+	reflectors := make([]householder, 0, n-1)
+
+	for k := 0; k < n-1; k++ {
+		x := make([]float64, n-k)
+		for i := range x {
+			x[i] = r_data[k+i][k]
+		}
+
+		v, beta, ok := newHouseholder(x)
+		if !ok {
+			continue
+		}
+
+		applyHouseholderLeft(r_data, k, v, beta, n)
+		reflectors = append(reflectors, householder{k: k, v: v, beta: beta})
+	}
+
+	q_data := make([][]float64, n)
+	for i := range q_data {
+		q_data[i] = make([]float64, n)
+		q_data[i][i] = 1
+	}
+
+	for i := len(reflectors) - 1; i >= 0; i-- {
+		ref := reflectors[i]
+		applyHouseholderLeft(q_data, ref.k, ref.v, ref.beta, n)
+	}
+
+	return NewMatrix(q_data), NewMatrix(r_data), nil
+}
+
+// tridiagonalize reduces the symmetric matrix represented by data to tridiagonal form in place via a
+// sequence of Householder similarity transforms (T = H*A*H for each reflector H), which preserves A's
+// eigenvalues while zeroing everything outside the main diagonal and its two neighbors.
+func tridiagonalize(data [][]float64, n int) {
+	for k := 0; k < n-2; k++ {
+		x := make([]float64, n-k-1)
+		for i := range x {
+			x[i] = data[k+1+i][k]
+		}
+
+		v, beta, ok := newHouseholder(x)
+		if !ok {
+			continue
+		}
+
+		applyHouseholderLeft(data, k+1, v, beta, n)
+		applyHouseholderRight(data, k+1, v, beta, n)
+	}
+}
+
+// EigenvaluesSymmetric returns the eigenvalues of a symmetric matrix, sorted descending, computed with
+// the shifted QR algorithm: the matrix is first tridiagonalized via Householder similarity transforms,
+// then repeatedly QR-factored after subtracting a Wilkinson shift (derived from the trailing 2x2
+// block) until the last off-diagonal entry becomes negligible, at which point that row/column is
+// deflated out of the active submatrix. Iteration stops once the matrix is fully diagonal or after
+// maxIter iterations, whichever comes first. tol controls both the deflation threshold and, scaled by
+// the matrix's own magnitude, what counts as numerically symmetric.
+func (m *Matrix) EigenvaluesSymmetric(maxIter int, tol float64) ([]float64, error) {
+	if m.lines != m.columns {
+		return nil, errors.New("matrix must be square")
+	}
+
+	n := m.lines
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			a, b := m.data[i][j], m.data[j][i]
+			bound := tol * max3(1, abs(a), abs(b))
+			if abs(a-b) > bound {
+				return nil, errors.New("matrix must be symmetric")
+			}
+		}
+	}
+
+	t_data := make([][]float64, n)
+	for i := range t_data {
+		t_data[i] = make([]float64, n)
+		copy(t_data[i], m.data[i])
+	}
+	tridiagonalize(t_data, n)
+
+	size := n
+	for size > 1 && maxIter > 0 {
+		a := t_data[size-2][size-2]
+		b := t_data[size-2][size-1]
+		c := t_data[size-1][size-2]
+		d := t_data[size-1][size-1]
+
+		delta := (a - d) / 2
+		var mu float64
+		if delta == 0 {
+			mu = d - abs(b)
+		} else {
+			sign := 1.
+			if delta < 0 {
+				sign = -1.
+			}
+			mu = d - (b*c)/(delta+sign*math.Sqrt(delta*delta+b*c))
+		}
+
+		shifted := make([][]float64, size)
+		for i := 0; i < size; i++ {
+			shifted[i] = make([]float64, size)
+			copy(shifted[i], t_data[i][:size])
+			shifted[i][i] -= mu
+		}
+
+		Qs, Rs, err := NewMatrix(shifted).QR()
+		if err != nil {
+			return nil, err
+		}
+
+		RQ, err := Rs.Product(Qs)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := 0; i < size; i++ {
+			for j := 0; j < size; j++ {
+				v := RQ.data[i][j]
+				if i == j {
+					v += mu
+				}
+				t_data[i][j] = v
+			}
+		}
+
+		maxIter--
+
+		threshold := tol * (abs(t_data[size-2][size-2]) + abs(t_data[size-1][size-1]))
+		if abs(t_data[size-2][size-1]) < threshold {
+			size--
+		}
+	}
+
+	eigenvalues := make([]float64, n)
+	for i := 0; i < n; i++ {
+		eigenvalues[i] = t_data[i][i]
+	}
+	sort.Sort(sort.Reverse(sort.Float64Slice(eigenvalues)))
+
+	return eigenvalues, nil
+}
@@ -0,0 +1,73 @@
+package linalg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_QR(t *testing.T) {
+	A := NewMatrix([][]float64{
+		{12, -51, 4},
+		{6, 167, -68},
+		{-4, 24, -41},
+	})
+
+	Q, R, err := A.QR()
+	assert.Nil(t, err)
+
+	// Q*R should reproduce A
+	qr, err := Q.Product(R)
+	assert.Nil(t, err)
+	assert.True(t, A.ApproxEquals(qr, DefaultTolerance))
+
+	// Q should be orthogonal: Q^T * Q = I
+	qtq, err := Q.Transpose().Product(Q)
+	assert.Nil(t, err)
+	assert.True(t, NewIdentityMatrix(3).ApproxEquals(qtq, DefaultTolerance))
+
+	_, _, err = NewMatrix([][]float64{{1, 2, 3}}).QR()
+	assert.Error(t, err)
+}
+
+func Test_EigenvaluesSymmetric(t *testing.T) {
+	A := NewMatrix([][]float64{
+		{2, 1},
+		{1, 2},
+	})
+
+	eigen, err := A.EigenvaluesSymmetric(100, DefaultTolerance)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(eigen))
+	assert.InDelta(t, 3., eigen[0], 1e-6)
+	assert.InDelta(t, 1., eigen[1], 1e-6)
+
+	// a non symmetric matrix should be rejected
+	_, err = NewMatrix([][]float64{
+		{1, 2},
+		{3, 4},
+	}).EigenvaluesSymmetric(100, DefaultTolerance)
+	assert.Error(t, err)
+
+	// a larger symmetric matrix, eigenvalues should be sorted descending
+	B := NewMatrix([][]float64{
+		{4, 1, -2},
+		{1, 2, 0},
+		{-2, 0, 3},
+	})
+
+	eigenB, err := B.EigenvaluesSymmetric(200, DefaultTolerance)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(eigenB))
+	assert.True(t, eigenB[0] >= eigenB[1])
+	assert.True(t, eigenB[1] >= eigenB[2])
+
+	// eigenvalues should sum to the trace
+	trace := 0.
+	for i := 0; i < 3; i++ {
+		v, _ := B.Position(i+1, i+1)
+		trace += v
+	}
+	sum := eigenB[0] + eigenB[1] + eigenB[2]
+	assert.InDelta(t, trace, sum, 1e-6)
+}
@@ -0,0 +1,187 @@
+package linalg
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Vector represents a one-dimensional array of float64 values and provides the BLAS level-1
+// operations (dot products, norms, axpy, index lookups), such as finding the largest absolute value
+// in a column extracted with [Matrix.Column]. The matrix decompositions in this package do not use
+// Vector; they work on the matrix data directly.
+type Vector struct {
+	data []float64
+}
+
+// NewVector creates a vector from a float64 slice and returns its pointer
+func NewVector(data []float64) *Vector {
+	return &Vector{data: data}
+}
+
+// Size returns the number of elements in the vector
+func (v *Vector) Size() int {
+	return len(v.data)
+}
+
+// At returns the value at the given 0-based index of the vector, or an error if the index is out of range.
+// This intentionally departs from [Matrix.Position]'s 1-based indexing: vectors follow plain Go slice
+// conventions instead of the mathematical convention used for matrix coordinates.
+func (v *Vector) At(i int) (float64, error) {
+	if i < 0 || i >= len(v.data) {
+		return 0, errors.New("index out of range")
+	}
+	return v.data[i], nil
+}
+
+// Imax returns the 0-based index of the largest signed value in the vector
+func (v *Vector) Imax() (int, error) {
+	if len(v.data) == 0 {
+		return 0, errors.New("empty vector")
+	}
+
+	idx := 0
+	for i := 1; i < len(v.data); i++ {
+		if v.data[i] > v.data[idx] {
+			idx = i
+		}
+	}
+	return idx, nil
+}
+
+// Imin returns the 0-based index of the smallest signed value in the vector
+func (v *Vector) Imin() (int, error) {
+	if len(v.data) == 0 {
+		return 0, errors.New("empty vector")
+	}
+
+	idx := 0
+	for i := 1; i < len(v.data); i++ {
+		if v.data[i] < v.data[idx] {
+			idx = i
+		}
+	}
+	return idx, nil
+}
+
+// Iamax returns the 0-based index of the largest absolute value in the vector
+func (v *Vector) Iamax() (int, error) {
+	if len(v.data) == 0 {
+		return 0, errors.New("empty vector")
+	}
+
+	idx := 0
+	for i := 1; i < len(v.data); i++ {
+		if abs(v.data[i]) > abs(v.data[idx]) {
+			idx = i
+		}
+	}
+	return idx, nil
+}
+
+// Iamin returns the 0-based index of the smallest absolute value in the vector
+func (v *Vector) Iamin() (int, error) {
+	if len(v.data) == 0 {
+		return 0, errors.New("empty vector")
+	}
+
+	idx := 0
+	for i := 1; i < len(v.data); i++ {
+		if abs(v.data[i]) < abs(v.data[idx]) {
+			idx = i
+		}
+	}
+	return idx, nil
+}
+
+// Dot returns the dot product of the vector with another one of the same size, or an error if the
+// sizes don't match
+func (v *Vector) Dot(b *Vector) (float64, error) {
+	if len(v.data) != len(b.data) {
+		return 0, errors.New("vectors must have the same size")
+	}
+
+	sum := 0.
+	for i := range v.data {
+		sum += v.data[i] * b.data[i]
+	}
+	return sum, nil
+}
+
+// Norm returns the Euclidean (L2) norm of the vector
+func (v *Vector) Norm() float64 {
+	sum := 0.
+	for _, x := range v.data {
+		sum += x * x
+	}
+	return math.Sqrt(sum)
+}
+
+// Norm1 returns the taxicab (L1) norm of the vector: the sum of the absolute values of its elements
+func (v *Vector) Norm1() float64 {
+	sum := 0.
+	for _, x := range v.data {
+		sum += abs(x)
+	}
+	return sum
+}
+
+// NormInf returns the infinity norm of the vector: the largest absolute value among its elements
+func (v *Vector) NormInf() float64 {
+	max := 0.
+	for _, x := range v.data {
+		if a := abs(x); a > max {
+			max = a
+		}
+	}
+	return max
+}
+
+// Axpy computes alpha*x + self (the BLAS "axpy" operation) and returns the result as a new vector.
+// x must have the same size as the vector or an error is returned.
+func (v *Vector) Axpy(alpha float64, x *Vector) (*Vector, error) {
+	if len(v.data) != len(x.data) {
+		return nil, errors.New("vectors must have the same size")
+	}
+
+	result := make([]float64, len(v.data))
+	for i := range result {
+		result[i] = alpha*x.data[i] + v.data[i]
+	}
+	return NewVector(result), nil
+}
+
+func (v *Vector) String() string {
+	builder := strings.Builder{}
+	builder.WriteString("[")
+	for _, val := range v.data {
+		builder.WriteString(fmt.Sprintf("%v ", val))
+	}
+	builder.WriteString("]")
+	return builder.String()
+}
+
+// Row returns the i-th row of the matrix as a [Vector], using 1-based indexing like [Matrix.Position]
+func (m *Matrix) Row(i int) (*Vector, error) {
+	if i < 1 || i > m.lines {
+		return nil, errors.New("row number is out of range")
+	}
+
+	data := make([]float64, m.columns)
+	copy(data, m.data[i-1])
+	return NewVector(data), nil
+}
+
+// Column returns the j-th column of the matrix as a [Vector], using 1-based indexing like [Matrix.Position]
+func (m *Matrix) Column(j int) (*Vector, error) {
+	if j < 1 || j > m.columns {
+		return nil, errors.New("column number is out of range")
+	}
+
+	data := make([]float64, m.lines)
+	for i := 0; i < m.lines; i++ {
+		data[i] = m.data[i][j-1]
+	}
+	return NewVector(data), nil
+}
@@ -0,0 +1,111 @@
+package linalg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Vector_Indexes(t *testing.T) {
+	v := NewVector([]float64{3, -9, 4, 2})
+
+	idx, err := v.Imax()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, idx)
+
+	idx, err = v.Imin()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, idx)
+
+	idx, err = v.Iamax()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, idx)
+
+	idx, err = v.Iamin()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, idx)
+
+	empty := NewVector([]float64{})
+	_, err = empty.Imax()
+	assert.Error(t, err)
+	_, err = empty.Iamax()
+	assert.Error(t, err)
+}
+
+func Test_Vector_At(t *testing.T) {
+	v := NewVector([]float64{1, 2, 3})
+
+	val, err := v.At(0)
+	assert.Nil(t, err)
+	assert.Equal(t, 1., val)
+
+	_, err = v.At(3)
+	assert.Error(t, err)
+
+	_, err = v.At(-1)
+	assert.Error(t, err)
+}
+
+func Test_Vector_Dot(t *testing.T) {
+	a := NewVector([]float64{1, 2, 3})
+	b := NewVector([]float64{4, 5, 6})
+
+	dot, err := a.Dot(b)
+	assert.Nil(t, err)
+	assert.Equal(t, 32., dot)
+
+	c := NewVector([]float64{1, 2})
+	_, err = a.Dot(c)
+	assert.Error(t, err)
+}
+
+func Test_Vector_Norms(t *testing.T) {
+	v := NewVector([]float64{3, -4})
+
+	assert.Equal(t, 5., v.Norm())
+	assert.Equal(t, 7., v.Norm1())
+	assert.Equal(t, 4., v.NormInf())
+}
+
+func Test_Vector_Axpy(t *testing.T) {
+	y := NewVector([]float64{1, 1, 1})
+	x := NewVector([]float64{1, 2, 3})
+
+	result, err := y.Axpy(2, x)
+	assert.Nil(t, err)
+
+	val, _ := result.At(0)
+	assert.Equal(t, 3., val)
+
+	val, _ = result.At(1)
+	assert.Equal(t, 5., val)
+
+	val, _ = result.At(2)
+	assert.Equal(t, 7., val)
+
+	_, err = y.Axpy(2, NewVector([]float64{1, 2}))
+	assert.Error(t, err)
+}
+
+func Test_Matrix_RowColumn(t *testing.T) {
+	A := NewMatrix([][]float64{
+		{1, 2, 3},
+		{4, 5, 6},
+	})
+
+	row, err := A.Row(1)
+	assert.Nil(t, err)
+	val, _ := row.At(2)
+	assert.Equal(t, 3., val)
+
+	col, err := A.Column(2)
+	assert.Nil(t, err)
+	val, _ = col.At(1)
+	assert.Equal(t, 5., val)
+
+	_, err = A.Row(0)
+	assert.Error(t, err)
+
+	_, err = A.Column(4)
+	assert.Error(t, err)
+}
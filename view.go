@@ -0,0 +1,88 @@
+package linalg
+
+// MatrixView is satisfied by anything that can report its dimensions and be read at a given
+// coordinate, without requiring the underlying data to be stored as a dense [][]float64. *Matrix
+// satisfies it directly, and [TransposeView] and [SubmatrixView] satisfy it by delegating to another
+// MatrixView, so operations built on top of the interface (like [Matrix.Sum], [Matrix.Product] or
+// [Matrix.Equals]) work the same whether they're handed a dense matrix or a view over one.
+type MatrixView interface {
+	// Dims returns the number of rows and columns of the view
+	Dims() (rows, cols int)
+	// At returns the value at the given 0-based row and column
+	At(i, j int) float64
+}
+
+// Dims returns the number of rows and columns of the matrix, satisfying [MatrixView]
+func (m *Matrix) Dims() (rows, cols int) {
+	return m.lines, m.columns
+}
+
+// At returns the value at the given 0-based row and column, satisfying [MatrixView].
+// Unlike [Matrix.Position], which is 1-based and returns an error, At panics on out-of-range
+// indices, following the convention used by the rest of the view types for cheap, direct access.
+func (m *Matrix) At(i, j int) float64 {
+	return m.data[i][j]
+}
+
+// TransposeView is a [MatrixView] over another view that reads it transposed, without copying
+// any data: TransposeView.At(i, j) simply reads M.At(j, i).
+type TransposeView struct {
+	M MatrixView
+}
+
+// Dims returns the transposed dimensions of the underlying view
+func (t TransposeView) Dims() (rows, cols int) {
+	rows, cols = t.M.Dims()
+	return cols, rows
+}
+
+// At returns the value at the given 0-based row and column of the transposed view
+func (t TransposeView) At(i, j int) float64 {
+	return t.M.At(j, i)
+}
+
+// SubmatrixView is a [MatrixView] over a rectangular slice of another view, spanning rows
+// [r0, r1) and columns [c0, c1), without copying any data.
+type SubmatrixView struct {
+	M              MatrixView
+	r0, c0, r1, c1 int
+}
+
+// Dims returns the dimensions of the submatrix
+func (s SubmatrixView) Dims() (rows, cols int) {
+	return s.r1 - s.r0, s.c1 - s.c0
+}
+
+// At returns the value at the given 0-based row and column of the submatrix, offset into the
+// underlying view
+func (s SubmatrixView) At(i, j int) float64 {
+	return s.M.At(s.r0+i, s.c0+j)
+}
+
+// TView returns a zero-copy [MatrixView] of the matrix transposed. Unlike [Matrix.Transpose], it
+// doesn't allocate a new [][]float64; it's cheap to create and can be passed wherever a
+// [MatrixView] is accepted, as in B.Product(A.TView()). The returned view has only Dims and At, so
+// it cannot itself be the receiver of Product; use [Materialize] for a dense copy that can.
+func (m *Matrix) TView() MatrixView {
+	return TransposeView{M: m}
+}
+
+// Slice returns a zero-copy [MatrixView] over the rectangular region spanning rows [r0, r1) and
+// columns [c0, c1) of the matrix, using 0-based indexing.
+func (m *Matrix) Slice(r0, c0, r1, c1 int) MatrixView {
+	return SubmatrixView{M: m, r0: r0, c0: c0, r1: r1, c1: c1}
+}
+
+// Materialize copies a [MatrixView] into a dense [*Matrix], for when a caller needs an actual copy
+// of the data instead of a cheap view over it.
+func Materialize(v MatrixView) *Matrix {
+	rows, cols := v.Dims()
+	data := make([][]float64, rows)
+	for i := range data {
+		data[i] = make([]float64, cols)
+		for j := range data[i] {
+			data[i][j] = v.At(i, j)
+		}
+	}
+	return NewMatrix(data)
+}
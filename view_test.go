@@ -0,0 +1,69 @@
+package linalg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TView(t *testing.T) {
+	A := NewMatrix([][]float64{
+		{1, 2, 3},
+		{4, 5, 6},
+	})
+
+	view := A.TView()
+	rows, cols := view.Dims()
+	assert.Equal(t, 3, rows)
+	assert.Equal(t, 2, cols)
+	assert.Equal(t, 2., view.At(1, 0))
+	assert.Equal(t, 6., view.At(2, 1))
+
+	// a transpose view should agree with a materialized transpose
+	assert.True(t, A.Transpose().Equals(view))
+	assert.True(t, Materialize(view).Equals(A.Transpose()))
+}
+
+func Test_Slice(t *testing.T) {
+	A := NewMatrix([][]float64{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	})
+
+	view := A.Slice(1, 1, 3, 3)
+	rows, cols := view.Dims()
+	assert.Equal(t, 2, rows)
+	assert.Equal(t, 2, cols)
+	assert.Equal(t, 5., view.At(0, 0))
+	assert.Equal(t, 9., view.At(1, 1))
+
+	expected := NewMatrix([][]float64{
+		{5, 6},
+		{8, 9},
+	})
+	assert.True(t, expected.Equals(view))
+}
+
+func Test_Materialize(t *testing.T) {
+	A := NewMatrix([][]float64{
+		{1, 2},
+		{3, 4},
+	})
+
+	m := Materialize(A.TView())
+	assert.True(t, m.Equals(A.Transpose()))
+}
+
+func Test_ViewChainedProduct(t *testing.T) {
+	A := NewMatrix([][]float64{
+		{1, 2, 3},
+		{4, 5, 6},
+	})
+
+	result, err := NewIdentityMatrix(3).Product(A.TView())
+	assert.Nil(t, err)
+
+	expected, _ := NewIdentityMatrix(3).Product(A.Transpose())
+	assert.True(t, expected.Equals(result))
+}